@@ -0,0 +1,28 @@
+package com.example.dummy_api;
+
+import java.lang.annotation.Documented;
+import java.lang.annotation.ElementType;
+import java.lang.annotation.Retention;
+import java.lang.annotation.RetentionPolicy;
+import java.lang.annotation.Target;
+
+/**
+ * Marks a route (handler method or controller) or a request parameter as
+ * deprecated. {@link DeprecationInterceptor} then answers with Deprecation
+ * (RFC 9745), Sunset (RFC 8594) and Warning headers; for a parameter only
+ * when the request actually uses it.
+ */
+@Documented
+@Retention(RetentionPolicy.RUNTIME)
+@Target({ ElementType.TYPE, ElementType.METHOD, ElementType.PARAMETER })
+public @interface ApiDeprecated {
+
+    /** ISO date the deprecation took effect, e.g. "2025-01-01". */
+    String since();
+
+    /** ISO date after which it may be removed; empty if not scheduled. */
+    String sunset() default "";
+
+    /** Migration docs, sent as a Link with rel="deprecation"; empty for none. */
+    String link() default "";
+}
@@ -0,0 +1,53 @@
+package com.example.dummy_api;
+
+import java.util.Locale;
+
+import org.springframework.context.MessageSource;
+import org.springframework.http.HttpStatus;
+import org.springframework.http.ProblemDetail;
+import org.springframework.web.bind.MissingServletRequestParameterException;
+import org.springframework.web.bind.annotation.ExceptionHandler;
+import org.springframework.web.bind.annotation.RestControllerAdvice;
+import org.springframework.web.method.annotation.MethodArgumentTypeMismatchException;
+
+/**
+ * Turns request errors into RFC 9457 problem details. The detail is localized
+ * from messages*.properties using the request's Accept-Language, and the
+ * untranslated key is included as "messageKey" for programmatic handling.
+ */
+@RestControllerAdvice
+public class ApiExceptionHandler {
+    private final MessageSource messageSource;
+
+    public ApiExceptionHandler(MessageSource messageSource) {
+        this.messageSource = messageSource;
+    }
+
+    @ExceptionHandler(BadRequestException.class)
+    public ProblemDetail handleBadRequest(BadRequestException ex, Locale locale) {
+        return problem(ex.getMessageKey(), ex.getArgs(), locale);
+    }
+
+    // Controllers can give a parameter its own wording by adding an
+    // error.<param>.invalid key; otherwise the generic message is used.
+    @ExceptionHandler(MethodArgumentTypeMismatchException.class)
+    public ProblemDetail handleTypeMismatch(MethodArgumentTypeMismatchException ex, Locale locale) {
+        var key = "error." + ex.getName() + ".invalid";
+        if (messageSource.getMessage(key, null, null, Locale.ROOT) == null) {
+            key = "error.parameter.invalid";
+        }
+        return problem(key, new Object[] { ex.getName(), ex.getValue() }, locale);
+    }
+
+    @ExceptionHandler(MissingServletRequestParameterException.class)
+    public ProblemDetail handleMissingParameter(MissingServletRequestParameterException ex, Locale locale) {
+        return problem("error.parameter.missing", new Object[] { ex.getParameterName() }, locale);
+    }
+
+    private ProblemDetail problem(String key, Object[] args, Locale locale) {
+        var problem = ProblemDetail.forStatusAndDetail(HttpStatus.BAD_REQUEST,
+                messageSource.getMessage(key, args, locale));
+        problem.setProperty("messageKey", key);
+        return problem;
+    }
+}
@@ -0,0 +1,26 @@
+package com.example.dummy_api;
+
+/**
+ * A 400 error whose detail is looked up in the message catalog, so clients
+ * get it in their Accept-Language and can switch on the key instead.
+ * Parameter errors use keys of the form error.&lt;param&gt;.invalid with the
+ * parameter name and rejected value as arguments.
+ */
+public class BadRequestException extends RuntimeException {
+    private final String messageKey;
+    private final Object[] args;
+
+    public BadRequestException(String messageKey, Object... args) {
+        super(messageKey);
+        this.messageKey = messageKey;
+        this.args = args;
+    }
+
+    public String getMessageKey() {
+        return messageKey;
+    }
+
+    public Object[] getArgs() {
+        return args;
+    }
+}
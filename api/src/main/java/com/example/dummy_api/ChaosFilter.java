@@ -0,0 +1,78 @@
+package com.example.dummy_api;
+
+import java.io.IOException;
+import java.util.concurrent.ThreadLocalRandom;
+
+import jakarta.servlet.FilterChain;
+import jakarta.servlet.ServletException;
+import jakarta.servlet.http.HttpServletRequest;
+import jakarta.servlet.http.HttpServletResponse;
+
+import org.springframework.boot.autoconfigure.condition.ConditionalOnProperty;
+import org.springframework.boot.context.properties.EnableConfigurationProperties;
+import org.springframework.context.annotation.Profile;
+import org.springframework.http.HttpHeaders;
+import org.springframework.stereotype.Component;
+import org.springframework.util.AntPathMatcher;
+import org.springframework.web.filter.OncePerRequestFilter;
+
+/**
+ * Injects latency, errors and dropped connections per route so client teams
+ * can exercise their retry logic. Never registered under the prod profile,
+ * and off unless app.chaos.enabled=true.
+ */
+@Component
+@Profile("!prod")
+@ConditionalOnProperty(name = "app.chaos.enabled", havingValue = "true")
+@EnableConfigurationProperties(ChaosProperties.class)
+public class ChaosFilter extends OncePerRequestFilter {
+    private final AntPathMatcher pathMatcher = new AntPathMatcher();
+    private final ChaosProperties properties;
+
+    public ChaosFilter(ChaosProperties properties) {
+        this.properties = properties;
+    }
+
+    @Override
+    protected void doFilterInternal(HttpServletRequest request, HttpServletResponse response, FilterChain chain)
+            throws ServletException, IOException {
+        var route = match(request.getRequestURI());
+        if (route == null) {
+            chain.doFilter(request, response);
+            return;
+        }
+
+        if (route.latency() != null) {
+            try {
+                Thread.sleep(route.latency().toMillis());
+            } catch (InterruptedException e) {
+                Thread.currentThread().interrupt();
+            }
+        }
+
+        var random = ThreadLocalRandom.current();
+        if (random.nextDouble() < route.dropRate()) {
+            // Promise a body and close without sending it, so the client sees
+            // the connection end mid-response instead of a clean error.
+            response.setHeader(HttpHeaders.CONNECTION, "close");
+            response.setContentLength(1);
+            response.flushBuffer();
+            return;
+        }
+        if (random.nextDouble() < route.errorRate()) {
+            response.sendError(route.errorStatus(), "Injected fault");
+            return;
+        }
+
+        chain.doFilter(request, response);
+    }
+
+    private ChaosProperties.Route match(String path) {
+        for (var route : properties.routes()) {
+            if (pathMatcher.match(route.path(), path)) {
+                return route;
+            }
+        }
+        return null;
+    }
+}
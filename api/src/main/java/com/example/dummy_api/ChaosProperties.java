@@ -0,0 +1,26 @@
+package com.example.dummy_api;
+
+import java.time.Duration;
+import java.util.List;
+
+import org.springframework.boot.context.properties.ConfigurationProperties;
+import org.springframework.boot.context.properties.bind.DefaultValue;
+
+/**
+ * Fault injection settings for {@link ChaosFilter}. The first route whose
+ * Ant-style path pattern matches the request is applied.
+ */
+@ConfigurationProperties("app.chaos")
+public record ChaosProperties(@DefaultValue List<Route> routes) {
+
+    /**
+     * @param path       Ant-style pattern, e.g. /api/fibonacci or /api/**
+     * @param latency    delay added before the request is handled
+     * @param errorRate  fraction of requests (0-1) answered with errorStatus
+     * @param errorStatus status sent for injected errors
+     * @param dropRate   fraction of requests (0-1) whose connection is dropped
+     */
+    public record Route(String path, Duration latency, double errorRate,
+            @DefaultValue("503") int errorStatus, double dropRate) {
+    }
+}
@@ -0,0 +1,158 @@
+package com.example.dummy_api;
+
+import java.lang.reflect.Method;
+import java.time.LocalDate;
+import java.time.ZoneOffset;
+import java.time.format.DateTimeParseException;
+import java.util.ArrayList;
+import java.util.Collection;
+import java.util.List;
+import java.util.Map;
+import java.util.concurrent.ConcurrentHashMap;
+
+import io.micrometer.core.instrument.MeterRegistry;
+import jakarta.servlet.http.HttpServletRequest;
+import jakarta.servlet.http.HttpServletResponse;
+
+import org.springframework.core.DefaultParameterNameDiscoverer;
+import org.springframework.core.MethodParameter;
+import org.springframework.core.ParameterNameDiscoverer;
+import org.springframework.core.annotation.AnnotatedElementUtils;
+import org.springframework.web.bind.annotation.RequestParam;
+import org.springframework.web.method.HandlerMethod;
+import org.springframework.web.servlet.HandlerInterceptor;
+import org.springframework.web.servlet.HandlerMapping;
+
+/**
+ * Emits deprecation headers for handlers and parameters marked with
+ * {@link ApiDeprecated} and counts each use in api.deprecated.usage, tagged
+ * by route pattern and parameter ("none" for a deprecated route), so we can
+ * tell when nobody calls them any more. When both a route and a parameter
+ * are deprecated the route's dates win; each gets its own Warning.
+ */
+public class DeprecationInterceptor implements HandlerInterceptor {
+    public static final String USAGE_METRIC = "api.deprecated.usage";
+
+    private static final ParameterNameDiscoverer PARAMETER_NAMES = new DefaultParameterNameDiscoverer();
+
+    private final MeterRegistry meterRegistry;
+    private final Map<Method, Deprecations> deprecations = new ConcurrentHashMap<>();
+
+    public DeprecationInterceptor(MeterRegistry meterRegistry) {
+        this.meterRegistry = meterRegistry;
+    }
+
+    /**
+     * Parses the annotations on the given handlers up front, so a malformed
+     * date fails startup instead of every request to that route.
+     */
+    public void precompute(Collection<HandlerMethod> handlerMethods) {
+        handlerMethods.forEach(this::deprecations);
+    }
+
+    @Override
+    public boolean preHandle(HttpServletRequest request, HttpServletResponse response, Object handler) {
+        if (!(handler instanceof HandlerMethod method)) {
+            return true;
+        }
+
+        var deprecations = deprecations(method);
+        if (deprecations.route() == null && deprecations.parameters().isEmpty()) {
+            return true;
+        }
+
+        var pattern = request.getAttribute(HandlerMapping.BEST_MATCHING_PATTERN_ATTRIBUTE);
+        var route = pattern != null ? pattern.toString() : "unknown";
+        if (deprecations.route() != null) {
+            mark(response, deprecations.route(), request.getMethod() + " " + request.getRequestURI());
+            countUsage(route, "none");
+        }
+        for (var parameter : deprecations.parameters()) {
+            if (request.getParameter(parameter.name()) != null) {
+                mark(response, parameter.deprecation(), "Parameter '" + parameter.name() + "'");
+                countUsage(route, parameter.name());
+            }
+        }
+        return true;
+    }
+
+    private Deprecations deprecations(HandlerMethod method) {
+        return deprecations.computeIfAbsent(method.getMethod(), m -> parse(method));
+    }
+
+    private void countUsage(String route, String parameter) {
+        meterRegistry.counter(USAGE_METRIC, "route", route, "parameter", parameter).increment();
+    }
+
+    private static void mark(HttpServletResponse response, Deprecation deprecation, String subject) {
+        if (!response.containsHeader("Deprecation")) {
+            response.setHeader("Deprecation", "@" + deprecation.since());
+            if (deprecation.sunsetDate() != null) {
+                response.setDateHeader("Sunset", deprecation.sunset() * 1000);
+            }
+        }
+        if (deprecation.link() != null) {
+            response.addHeader("Link", "<" + deprecation.link() + ">; rel=\"deprecation\"");
+        }
+
+        var warning = subject + " is deprecated";
+        if (deprecation.sunsetDate() != null) {
+            warning += " and will be removed after " + deprecation.sunsetDate();
+        }
+        response.addHeader("Warning", "299 - \"" + warning + "\"");
+    }
+
+    private static Deprecations parse(HandlerMethod method) {
+        var route = AnnotatedElementUtils.findMergedAnnotation(method.getMethod(), ApiDeprecated.class);
+        if (route == null) {
+            route = AnnotatedElementUtils.findMergedAnnotation(method.getBeanType(), ApiDeprecated.class);
+        }
+
+        try {
+            var parameters = new ArrayList<ParameterDeprecation>();
+            for (var parameter : method.getMethodParameters()) {
+                var deprecated = parameter.getParameterAnnotation(ApiDeprecated.class);
+                if (deprecated == null) {
+                    continue;
+                }
+                var name = parameterName(parameter);
+                if (name == null) {
+                    throw new IllegalStateException("Cannot determine the name of deprecated parameter "
+                            + parameter.getParameterIndex() + " of " + method);
+                }
+                parameters.add(new ParameterDeprecation(name, Deprecation.of(deprecated)));
+            }
+            return new Deprecations(route != null ? Deprecation.of(route) : null, List.copyOf(parameters));
+        } catch (DateTimeParseException e) {
+            throw new IllegalStateException(
+                    "Invalid @ApiDeprecated date '" + e.getParsedString() + "' on " + method, e);
+        }
+    }
+
+    private static String parameterName(MethodParameter parameter) {
+        var requestParam = parameter.getParameterAnnotation(RequestParam.class);
+        if (requestParam != null && !requestParam.name().isEmpty()) {
+            return requestParam.name();
+        }
+        parameter.initParameterNameDiscovery(PARAMETER_NAMES);
+        return parameter.getParameterName();
+    }
+
+    private static long epochSecond(String isoDate) {
+        return LocalDate.parse(isoDate).atStartOfDay(ZoneOffset.UTC).toEpochSecond();
+    }
+
+    private record Deprecation(long since, long sunset, String sunsetDate, String link) {
+        static Deprecation of(ApiDeprecated annotation) {
+            var sunsetDate = annotation.sunset().isEmpty() ? null : annotation.sunset();
+            return new Deprecation(epochSecond(annotation.since()), sunsetDate != null ? epochSecond(sunsetDate) : 0,
+                    sunsetDate, annotation.link().isEmpty() ? null : annotation.link());
+        }
+    }
+
+    private record ParameterDeprecation(String name, Deprecation deprecation) {
+    }
+
+    private record Deprecations(Deprecation route, List<ParameterDeprecation> parameters) {
+    }
+}
@@ -4,8 +4,6 @@ import org.springframework.web.bind.annotation.GetMapping;
 import org.springframework.web.bind.annotation.RestController;
 import org.springframework.web.bind.annotation.RequestMapping;
 import org.springframework.web.bind.annotation.RequestParam;
-import org.springframework.http.HttpStatus;
-import org.springframework.web.server.ResponseStatusException;
 
 @RestController
 @RequestMapping("/api")
@@ -18,6 +16,10 @@ public class DummyController {
 
     @GetMapping("/fibonacci")
     public String fibonacciEndpoint(@RequestParam int n) {
+        if (n < 0) {
+            throw new BadRequestException("error.n.invalid", "n", String.valueOf(n));
+        }
+
         // Exponential slowdown due to naive recursion
         long result = fibonacci(n);
         return "Hello! Fibonacci of " + n + " is " + result;
@@ -33,7 +35,7 @@ public class DummyController {
     public String checkStatus(@RequestParam String status) {
         // Validate input
         if (!status.equals("Assigned") && !status.equals("Unassigned") && !status.equals("Unknown")) {
-            throw new ResponseStatusException(HttpStatus.BAD_REQUEST);
+            throw new BadRequestException("error.status.invalid", "status", status);
         }
 
         // Validate input
@@ -0,0 +1,49 @@
+package com.example.dummy_api;
+
+import java.time.Duration;
+
+import org.springframework.beans.factory.annotation.Value;
+import org.springframework.context.SmartLifecycle;
+import org.springframework.stereotype.Component;
+
+/**
+ * Holds shutdown for app.shutdown.pre-stop-delay before the web server stops
+ * accepting connections. Readiness is already REFUSING_TRAFFIC by the time
+ * this runs, so the delay gives load balancers time to notice and stop
+ * routing here while we keep answering whatever still arrives.
+ */
+@Component
+public class PreStopDelay implements SmartLifecycle {
+    private final Duration delay;
+    private volatile boolean running;
+
+    public PreStopDelay(@Value("${app.shutdown.pre-stop-delay:0s}") Duration delay) {
+        this.delay = delay;
+    }
+
+    @Override
+    public void start() {
+        running = true;
+    }
+
+    @Override
+    public void stop() {
+        running = false;
+        try {
+            Thread.sleep(delay.toMillis());
+        } catch (InterruptedException e) {
+            Thread.currentThread().interrupt();
+        }
+    }
+
+    @Override
+    public boolean isRunning() {
+        return running;
+    }
+
+    // Stops before the web server's graceful shutdown, which has a lower phase.
+    @Override
+    public int getPhase() {
+        return SmartLifecycle.DEFAULT_PHASE;
+    }
+}
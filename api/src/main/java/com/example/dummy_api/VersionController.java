@@ -0,0 +1,52 @@
+package com.example.dummy_api;
+
+import java.time.Instant;
+
+import org.springframework.beans.factory.ObjectProvider;
+import org.springframework.beans.factory.annotation.Value;
+import org.springframework.boot.info.BuildProperties;
+import org.springframework.boot.info.GitProperties;
+import org.springframework.util.StringUtils;
+import org.springframework.web.bind.annotation.GetMapping;
+import org.springframework.web.bind.annotation.RequestMapping;
+import org.springframework.web.bind.annotation.RestController;
+
+@RestController
+@RequestMapping("/api/v1")
+public class VersionController {
+    private final ObjectProvider<BuildProperties> buildProperties;
+    private final ObjectProvider<GitProperties> gitProperties;
+    private final String fallbackCommit;
+
+    public VersionController(ObjectProvider<BuildProperties> buildProperties,
+            ObjectProvider<GitProperties> gitProperties, @Value("${GIT_COMMIT:}") String fallbackCommit) {
+        this.buildProperties = buildProperties;
+        this.gitProperties = gitProperties;
+        this.fallbackCommit = fallbackCommit;
+    }
+
+    public record VersionInfo(String version, String commit, Instant buildTime, String javaVersion) {
+    }
+
+    // Build and git info only exist when packaged by Maven, so fields are
+    // null when running straight from an IDE.
+    @GetMapping("/version")
+    public VersionInfo version() {
+        var build = buildProperties.getIfAvailable();
+        return new VersionInfo(
+                build != null ? build.getVersion() : null,
+                commit(),
+                build != null ? build.getTime() : null,
+                System.getProperty("java.version"));
+    }
+
+    // git.properties is only generated when .git is present; the Docker
+    // image sets GIT_COMMIT from a build arg instead.
+    private String commit() {
+        var git = gitProperties.getIfAvailable();
+        if (git != null && git.getShortCommitId() != null) {
+            return git.getShortCommitId();
+        }
+        return StringUtils.hasText(fallbackCommit) ? fallbackCommit : null;
+    }
+}
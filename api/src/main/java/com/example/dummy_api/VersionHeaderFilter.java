@@ -0,0 +1,41 @@
+package com.example.dummy_api;
+
+import java.io.IOException;
+
+import jakarta.servlet.FilterChain;
+import jakarta.servlet.ServletException;
+import jakarta.servlet.http.HttpServletRequest;
+import jakarta.servlet.http.HttpServletResponse;
+
+import org.springframework.beans.factory.ObjectProvider;
+import org.springframework.boot.info.BuildProperties;
+import org.springframework.core.Ordered;
+import org.springframework.core.annotation.Order;
+import org.springframework.stereotype.Component;
+import org.springframework.web.filter.OncePerRequestFilter;
+
+/**
+ * Adds X-App-Version to every response so support can tell which build
+ * answered a request. Runs first so even errors from other filters carry it.
+ */
+@Component
+@Order(Ordered.HIGHEST_PRECEDENCE)
+public class VersionHeaderFilter extends OncePerRequestFilter {
+    public static final String HEADER = "X-App-Version";
+
+    private final String version;
+
+    public VersionHeaderFilter(ObjectProvider<BuildProperties> buildProperties) {
+        var build = buildProperties.getIfAvailable();
+        this.version = build != null ? build.getVersion() : null;
+    }
+
+    @Override
+    protected void doFilterInternal(HttpServletRequest request, HttpServletResponse response, FilterChain chain)
+            throws ServletException, IOException {
+        if (version != null) {
+            response.setHeader(HEADER, version);
+        }
+        chain.doFilter(request, response);
+    }
+}
@@ -0,0 +1,37 @@
+package com.example.dummy_api;
+
+import io.micrometer.core.instrument.MeterRegistry;
+
+import org.springframework.beans.factory.SmartInitializingSingleton;
+import org.springframework.beans.factory.annotation.Qualifier;
+import org.springframework.context.annotation.Bean;
+import org.springframework.context.annotation.Configuration;
+import org.springframework.web.servlet.config.annotation.InterceptorRegistry;
+import org.springframework.web.servlet.config.annotation.WebMvcConfigurer;
+import org.springframework.web.servlet.mvc.method.annotation.RequestMappingHandlerMapping;
+
+@Configuration
+public class WebConfig implements WebMvcConfigurer {
+    private final MeterRegistry meterRegistry;
+
+    public WebConfig(MeterRegistry meterRegistry) {
+        this.meterRegistry = meterRegistry;
+    }
+
+    @Bean
+    public DeprecationInterceptor deprecationInterceptor() {
+        return new DeprecationInterceptor(meterRegistry);
+    }
+
+    // Runs once every handler is mapped, so a bad @ApiDeprecated fails boot.
+    @Bean
+    public SmartInitializingSingleton deprecationPrecompute(DeprecationInterceptor deprecationInterceptor,
+            @Qualifier("requestMappingHandlerMapping") RequestMappingHandlerMapping handlerMapping) {
+        return () -> deprecationInterceptor.precompute(handlerMapping.getHandlerMethods().values());
+    }
+
+    @Override
+    public void addInterceptors(InterceptorRegistry registry) {
+        registry.addInterceptor(deprecationInterceptor());
+    }
+}
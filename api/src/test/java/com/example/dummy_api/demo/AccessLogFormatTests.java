@@ -0,0 +1,24 @@
+package com.example.dummy_api.demo;
+
+import static org.assertj.core.api.Assertions.assertThat;
+
+import org.junit.jupiter.api.Test;
+import org.springframework.beans.factory.annotation.Autowired;
+import org.springframework.boot.test.context.SpringBootTest;
+import org.springframework.core.env.Environment;
+
+@SpringBootTest(webEnvironment = SpringBootTest.WebEnvironment.NONE, properties = "app.access-log.format=json")
+class AccessLogFormatTests {
+
+	@Autowired
+	private Environment environment;
+
+	@Test
+	void formatSelectsTomcatPattern() {
+		assertThat(environment.getProperty("server.tomcat.accesslog.pattern"))
+				.startsWith("{\"time\":")
+				.contains("\"status\":%s")
+				.endsWith("}");
+	}
+
+}
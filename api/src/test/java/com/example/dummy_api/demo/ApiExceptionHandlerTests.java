@@ -0,0 +1,62 @@
+package com.example.dummy_api.demo;
+
+import static org.springframework.test.web.servlet.request.MockMvcRequestBuilders.get;
+import static org.springframework.test.web.servlet.result.MockMvcResultMatchers.content;
+import static org.springframework.test.web.servlet.result.MockMvcResultMatchers.jsonPath;
+import static org.springframework.test.web.servlet.result.MockMvcResultMatchers.status;
+
+import org.junit.jupiter.api.Test;
+import org.junit.jupiter.params.ParameterizedTest;
+import org.junit.jupiter.params.provider.CsvSource;
+import org.springframework.beans.factory.annotation.Autowired;
+import org.springframework.boot.test.autoconfigure.web.servlet.AutoConfigureMockMvc;
+import org.springframework.boot.test.context.SpringBootTest;
+import org.springframework.http.HttpHeaders;
+import org.springframework.http.MediaType;
+import org.springframework.test.web.servlet.MockMvc;
+import org.springframework.test.web.servlet.ResultActions;
+
+@SpringBootTest
+@AutoConfigureMockMvc
+class ApiExceptionHandlerTests {
+
+	@Autowired
+	private MockMvc mvc;
+
+	@ParameterizedTest
+	@CsvSource(delimiter = '|', quoteCharacter = '"', value = {
+			"sv | Ogiltig status 'Bogus'. Förväntade en av: Assigned, Unassigned, Unknown.",
+			"de | Ungültiger Status 'Bogus'. Erwartet wird einer von: Assigned, Unassigned, Unknown.",
+			"fr | Invalid status 'Bogus'. Expected one of: Assigned, Unassigned, Unknown."
+	})
+	void detailFollowsAcceptLanguage(String language, String detail) throws Exception {
+		expectProblem(mvc.perform(get("/api/check-status").param("status", "Bogus")
+				.header(HttpHeaders.ACCEPT_LANGUAGE, language)), "error.status.invalid", detail);
+	}
+
+	@Test
+	void negativeN() throws Exception {
+		expectProblem(mvc.perform(get("/api/fibonacci").param("n", "-1")), "error.n.invalid",
+				"Invalid value '-1' for n. Expected a non-negative integer.");
+	}
+
+	@Test
+	void nonNumericN() throws Exception {
+		expectProblem(mvc.perform(get("/api/fibonacci").param("n", "abc")), "error.n.invalid",
+				"Invalid value 'abc' for n. Expected a non-negative integer.");
+	}
+
+	@Test
+	void missingParameter() throws Exception {
+		expectProblem(mvc.perform(get("/api/check-status")), "error.parameter.missing",
+				"Missing required parameter 'status'.");
+	}
+
+	private static void expectProblem(ResultActions result, String messageKey, String detail) throws Exception {
+		result.andExpect(status().isBadRequest())
+				.andExpect(content().contentType(MediaType.APPLICATION_PROBLEM_JSON))
+				.andExpect(jsonPath("$.messageKey").value(messageKey))
+				.andExpect(jsonPath("$.detail").value(detail));
+	}
+
+}
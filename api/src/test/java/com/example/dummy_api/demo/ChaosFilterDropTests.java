@@ -0,0 +1,43 @@
+package com.example.dummy_api.demo;
+
+import static org.assertj.core.api.Assertions.assertThat;
+
+import java.io.ByteArrayOutputStream;
+import java.net.Socket;
+import java.nio.charset.StandardCharsets;
+
+import org.junit.jupiter.api.Test;
+import org.springframework.boot.test.context.SpringBootTest;
+import org.springframework.boot.test.web.server.LocalServerPort;
+
+// Dropping relies on how Tomcat handles a short response, which MockMvc
+// cannot show, so this talks raw HTTP to a real server.
+@SpringBootTest(webEnvironment = SpringBootTest.WebEnvironment.RANDOM_PORT, properties = {
+		"app.chaos.enabled=true",
+		"app.chaos.routes[0].path=/api/hello",
+		"app.chaos.routes[0].drop-rate=1"
+})
+class ChaosFilterDropTests {
+
+	@LocalServerPort
+	private int port;
+
+	@Test
+	void closesConnectionBeforePromisedBody() throws Exception {
+		String response;
+		try (var socket = new Socket("localhost", port)) {
+			socket.setSoTimeout(5000);
+			socket.getOutputStream().write("GET /api/hello HTTP/1.1\r\nHost: localhost\r\n\r\n"
+					.getBytes(StandardCharsets.US_ASCII));
+			var received = new ByteArrayOutputStream();
+			socket.getInputStream().transferTo(received);
+			response = received.toString(StandardCharsets.US_ASCII);
+		}
+
+		var headerEnd = response.indexOf("\r\n\r\n");
+		assertThat(headerEnd).isPositive();
+		assertThat(response.substring(0, headerEnd)).containsIgnoringCase("Content-Length: 1");
+		assertThat(response.substring(headerEnd + 4)).isEmpty();
+	}
+
+}
@@ -0,0 +1,30 @@
+package com.example.dummy_api.demo;
+
+import static org.springframework.test.web.servlet.request.MockMvcRequestBuilders.get;
+import static org.springframework.test.web.servlet.result.MockMvcResultMatchers.status;
+
+import org.junit.jupiter.api.Test;
+import org.springframework.beans.factory.annotation.Autowired;
+import org.springframework.boot.test.autoconfigure.web.servlet.AutoConfigureMockMvc;
+import org.springframework.boot.test.context.SpringBootTest;
+import org.springframework.test.context.ActiveProfiles;
+import org.springframework.test.web.servlet.MockMvc;
+
+@SpringBootTest(properties = {
+		"app.chaos.enabled=true",
+		"app.chaos.routes[0].path=/api/hello",
+		"app.chaos.routes[0].error-rate=1"
+})
+@AutoConfigureMockMvc
+@ActiveProfiles("prod")
+class ChaosFilterProdProfileTests {
+
+	@Autowired
+	private MockMvc mvc;
+
+	@Test
+	void isDisabledUnderProdProfile() throws Exception {
+		mvc.perform(get("/api/hello")).andExpect(status().isOk());
+	}
+
+}
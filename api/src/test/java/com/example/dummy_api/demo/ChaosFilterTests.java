@@ -0,0 +1,46 @@
+package com.example.dummy_api.demo;
+
+import static org.assertj.core.api.Assertions.assertThat;
+import static org.springframework.test.web.servlet.request.MockMvcRequestBuilders.get;
+import static org.springframework.test.web.servlet.result.MockMvcResultMatchers.status;
+
+import java.time.Duration;
+
+import org.junit.jupiter.api.Test;
+import org.springframework.beans.factory.annotation.Autowired;
+import org.springframework.boot.test.autoconfigure.web.servlet.AutoConfigureMockMvc;
+import org.springframework.boot.test.context.SpringBootTest;
+import org.springframework.test.web.servlet.MockMvc;
+
+@SpringBootTest(properties = {
+		"app.chaos.enabled=true",
+		"app.chaos.routes[0].path=/api/hello",
+		"app.chaos.routes[0].error-rate=1",
+		"app.chaos.routes[0].error-status=502",
+		"app.chaos.routes[1].path=/api/fibonacci",
+		"app.chaos.routes[1].latency=200ms"
+})
+@AutoConfigureMockMvc
+class ChaosFilterTests {
+
+	@Autowired
+	private MockMvc mvc;
+
+	@Test
+	void injectsErrorsOnMatchingRoute() throws Exception {
+		mvc.perform(get("/api/hello")).andExpect(status().isBadGateway());
+	}
+
+	@Test
+	void injectsLatencyOnMatchingRoute() throws Exception {
+		long start = System.nanoTime();
+		mvc.perform(get("/api/fibonacci").param("n", "1")).andExpect(status().isOk());
+		assertThat(Duration.ofNanos(System.nanoTime() - start)).isGreaterThanOrEqualTo(Duration.ofMillis(200));
+	}
+
+	@Test
+	void leavesOtherRoutesAlone() throws Exception {
+		mvc.perform(get("/api/check-status").param("status", "Assigned")).andExpect(status().isOk());
+	}
+
+}
@@ -0,0 +1,105 @@
+package com.example.dummy_api.demo;
+
+import static org.assertj.core.api.Assertions.assertThat;
+import static org.assertj.core.api.Assertions.assertThatIllegalStateException;
+import static org.springframework.test.web.servlet.request.MockMvcRequestBuilders.get;
+import static org.springframework.test.web.servlet.result.MockMvcResultMatchers.header;
+import static org.springframework.test.web.servlet.result.MockMvcResultMatchers.status;
+
+import java.time.Instant;
+import java.util.List;
+
+import io.micrometer.core.instrument.simple.SimpleMeterRegistry;
+import org.junit.jupiter.api.Test;
+import org.springframework.test.web.servlet.MockMvc;
+import org.springframework.test.web.servlet.setup.MockMvcBuilders;
+import org.springframework.web.bind.annotation.GetMapping;
+import org.springframework.web.bind.annotation.RequestParam;
+import org.springframework.web.bind.annotation.ResponseBody;
+import org.springframework.web.method.HandlerMethod;
+
+import com.example.dummy_api.ApiDeprecated;
+import com.example.dummy_api.DeprecationInterceptor;
+
+class DeprecationInterceptorTests {
+
+	private final SimpleMeterRegistry meterRegistry = new SimpleMeterRegistry();
+
+	private final DeprecationInterceptor interceptor = new DeprecationInterceptor(meterRegistry);
+
+	private final MockMvc mvc = MockMvcBuilders.standaloneSetup(new LegacyController())
+			.addInterceptors(interceptor)
+			.build();
+
+	@Test
+	void deprecatedRouteGetsHeaders() throws Exception {
+		mvc.perform(get("/legacy"))
+				.andExpect(status().isOk())
+				.andExpect(header().string("Deprecation", "@1735689600"))
+				.andExpect(header().dateValue("Sunset", Instant.parse("2026-07-01T00:00:00Z").toEpochMilli()))
+				.andExpect(header().string("Link", "<https://example.com/migrate>; rel=\"deprecation\""))
+				.andExpect(header().string("Warning",
+						"299 - \"GET /legacy is deprecated and will be removed after 2026-07-01\""));
+
+		assertThat(usage("/legacy", "none")).isEqualTo(1);
+	}
+
+	@Test
+	void deprecatedParameterOnlyFlaggedWhenUsed() throws Exception {
+		mvc.perform(get("/current"))
+				.andExpect(status().isOk())
+				.andExpect(header().doesNotExist("Deprecation"));
+		assertThat(usage("/current", "old")).isZero();
+
+		mvc.perform(get("/current").param("old", "x"))
+				.andExpect(status().isOk())
+				.andExpect(header().string("Deprecation", "@1735689600"))
+				.andExpect(header().doesNotExist("Sunset"))
+				.andExpect(header().string("Warning", "299 - \"Parameter 'old' is deprecated\""));
+		assertThat(usage("/current", "old")).isEqualTo(1);
+	}
+
+	@Test
+	void malformedDateFailsPrecompute() throws Exception {
+		var handler = new HandlerMethod(new MalformedController(), "broken");
+
+		assertThatIllegalStateException()
+				.isThrownBy(() -> interceptor.precompute(List.of(handler)))
+				.withMessageContaining("2025-13-01");
+	}
+
+	private double usage(String route, String parameter) {
+		var counter = meterRegistry.find(DeprecationInterceptor.USAGE_METRIC)
+				.tag("route", route)
+				.tag("parameter", parameter)
+				.counter();
+		return counter != null ? counter.count() : 0;
+	}
+
+	// Not a @Controller, so component scanning in other tests ignores it.
+	@ResponseBody
+	static class LegacyController {
+
+		@GetMapping("/legacy")
+		@ApiDeprecated(since = "2025-01-01", sunset = "2026-07-01", link = "https://example.com/migrate")
+		String legacy() {
+			return "legacy";
+		}
+
+		@GetMapping("/current")
+		String current(@ApiDeprecated(since = "2025-01-01") @RequestParam(name = "old", required = false) String old) {
+			return "current";
+		}
+
+	}
+
+	static class MalformedController {
+
+		@ApiDeprecated(since = "2025-13-01")
+		public String broken() {
+			return "broken";
+		}
+
+	}
+
+}
@@ -0,0 +1,51 @@
+package com.example.dummy_api.demo;
+
+import static org.assertj.core.api.Assertions.assertThat;
+
+import org.junit.jupiter.api.Test;
+import org.springframework.beans.factory.annotation.Autowired;
+import org.springframework.boot.availability.AvailabilityChangeEvent;
+import org.springframework.boot.availability.ReadinessState;
+import org.springframework.boot.test.context.SpringBootTest;
+import org.springframework.boot.test.web.client.TestRestTemplate;
+import org.springframework.boot.web.context.WebServerGracefulShutdownLifecycle;
+import org.springframework.context.ApplicationContext;
+import org.springframework.http.HttpStatus;
+import org.springframework.test.annotation.DirtiesContext;
+
+import com.example.dummy_api.PreStopDelay;
+
+@SpringBootTest(webEnvironment = SpringBootTest.WebEnvironment.RANDOM_PORT)
+class ProbeTests {
+
+	@Autowired
+	private TestRestTemplate rest;
+
+	@Autowired
+	private ApplicationContext context;
+
+	@Test
+	void probesAreServedOnMainPort() {
+		assertThat(rest.getForEntity("/readyz", String.class).getStatusCode()).isEqualTo(HttpStatus.OK);
+		assertThat(rest.getForEntity("/livez", String.class).getStatusCode()).isEqualTo(HttpStatus.OK);
+	}
+
+	@Test
+	@DirtiesContext
+	void readinessFailsOnceRefusingTraffic() {
+		AvailabilityChangeEvent.publish(context, ReadinessState.REFUSING_TRAFFIC);
+
+		assertThat(rest.getForEntity("/readyz", String.class).getStatusCode())
+				.isEqualTo(HttpStatus.SERVICE_UNAVAILABLE);
+		assertThat(rest.getForEntity("/livez", String.class).getStatusCode()).isEqualTo(HttpStatus.OK);
+	}
+
+	@Test
+	void preStopDelayStopsBeforeWebServerShutdown() {
+		var preStopDelay = context.getBean(PreStopDelay.class);
+		var gracefulShutdown = context.getBean(WebServerGracefulShutdownLifecycle.class);
+
+		assertThat(preStopDelay.getPhase()).isGreaterThan(gracefulShutdown.getPhase());
+	}
+
+}
@@ -0,0 +1,58 @@
+package com.example.dummy_api.demo;
+
+import static org.assertj.core.api.Assumptions.assumeThat;
+import static org.hamcrest.Matchers.hasKey;
+import static org.springframework.test.web.servlet.request.MockMvcRequestBuilders.get;
+import static org.springframework.test.web.servlet.result.MockMvcResultMatchers.header;
+import static org.springframework.test.web.servlet.result.MockMvcResultMatchers.jsonPath;
+import static org.springframework.test.web.servlet.result.MockMvcResultMatchers.status;
+
+import org.junit.jupiter.api.BeforeEach;
+import org.junit.jupiter.api.Test;
+import org.springframework.beans.factory.ObjectProvider;
+import org.springframework.beans.factory.annotation.Autowired;
+import org.springframework.boot.info.BuildProperties;
+import org.springframework.boot.test.autoconfigure.web.servlet.AutoConfigureMockMvc;
+import org.springframework.boot.test.context.SpringBootTest;
+import org.springframework.test.web.servlet.MockMvc;
+
+@SpringBootTest
+@AutoConfigureMockMvc
+class VersionControllerTests {
+
+	@Autowired
+	private MockMvc mvc;
+
+	@Autowired
+	private ObjectProvider<BuildProperties> buildProperties;
+
+	private BuildProperties build;
+
+	// build-info.properties is generated by Maven; IDE runs without it skip.
+	@BeforeEach
+	void requireBuildInfo() {
+		build = buildProperties.getIfAvailable();
+		assumeThat(build).isNotNull();
+	}
+
+	@Test
+	void reportsBuildAndRuntimeVersions() throws Exception {
+		mvc.perform(get("/api/v1/version"))
+				.andExpect(status().isOk())
+				.andExpect(jsonPath("$.version").value(build.getVersion()))
+				.andExpect(jsonPath("$.buildTime").isString())
+				.andExpect(jsonPath("$.javaVersion").value(System.getProperty("java.version")))
+				.andExpect(jsonPath("$").value(hasKey("commit")));
+	}
+
+	@Test
+	void versionHeaderOnEveryResponse() throws Exception {
+		mvc.perform(get("/api/hello"))
+				.andExpect(status().isOk())
+				.andExpect(header().string("X-App-Version", build.getVersion()));
+		mvc.perform(get("/api/check-status").param("status", "Bogus"))
+				.andExpect(status().isBadRequest())
+				.andExpect(header().string("X-App-Version", build.getVersion()));
+	}
+
+}
@@ -0,0 +1,177 @@
+package se.telavox.mcp;
+
+import java.io.IOException;
+import java.net.InetSocketAddress;
+import java.net.Proxy;
+import java.net.ProxySelector;
+import java.net.SocketAddress;
+import java.net.URI;
+import java.net.URISyntaxException;
+import java.util.Arrays;
+import java.util.List;
+import java.util.Map;
+
+import javax.net.ssl.X509TrustManager;
+
+import okhttp3.Authenticator;
+import okhttp3.Credentials;
+import okhttp3.OkHttpClient;
+import org.slf4j.Logger;
+import org.slf4j.LoggerFactory;
+import org.springframework.boot.context.properties.EnableConfigurationProperties;
+import org.springframework.boot.ssl.SslBundles;
+import org.springframework.context.annotation.Bean;
+import org.springframework.context.annotation.Configuration;
+import org.springframework.util.StringUtils;
+
+@Configuration
+@EnableConfigurationProperties(WeatherHttpProperties.class)
+public class WeatherHttpConfiguration {
+    private static final Logger log = LoggerFactory.getLogger(WeatherHttpConfiguration.class);
+    private static final List<String> PROXY_VARIABLES = List.of("HTTPS_PROXY", "https_proxy", "HTTP_PROXY",
+            "http_proxy");
+
+    @Bean
+    public OkHttpClient weatherHttpClient(WeatherHttpProperties properties, SslBundles sslBundles) {
+        var builder = new OkHttpClient.Builder()
+                .connectTimeout(properties.connectTimeout())
+                .readTimeout(properties.readTimeout())
+                .callTimeout(properties.callTimeout());
+
+        var proxySettings = resolveProxy(properties, System.getenv());
+        if (proxySettings != null) {
+            builder.proxySelector(new NonProxyHostsSelector(proxySettings.proxy(), proxySettings.nonProxyHosts()));
+            if (proxySettings.credentials() != null) {
+                builder.proxyAuthenticator(proxyAuthenticator(proxySettings.credentials()));
+            }
+        }
+
+        if (StringUtils.hasText(properties.sslBundle())) {
+            var bundle = sslBundles.getBundle(properties.sslBundle());
+            var trustManager = (X509TrustManager) bundle.getManagers().getTrustManagers()[0];
+            builder.sslSocketFactory(bundle.createSslContext().getSocketFactory(), trustManager);
+        }
+
+        return builder.build();
+    }
+
+    record ProxySettings(Proxy proxy, String credentials, List<String> nonProxyHosts) {
+    }
+
+    /**
+     * Picks the proxy from weather.http.proxy, then HTTPS_PROXY and HTTP_PROXY
+     * (either case). A bad weather.http.proxy fails startup; a bad inherited
+     * environment variable is logged and ignored. Returns null for no proxy.
+     */
+    static ProxySettings resolveProxy(WeatherHttpProperties properties, Map<String, String> env) {
+        String source = null;
+        String value = null;
+        if (StringUtils.hasText(properties.proxy())) {
+            source = "weather.http.proxy";
+            value = properties.proxy();
+        } else {
+            for (var name : PROXY_VARIABLES) {
+                if (StringUtils.hasText(env.get(name))) {
+                    source = name;
+                    value = env.get(name);
+                    break;
+                }
+            }
+        }
+        if (value == null) {
+            return null;
+        }
+
+        // The value is left out of messages as it may carry credentials.
+        var uri = parseProxyUrl(value);
+        if (uri == null) {
+            if (source.equals("weather.http.proxy")) {
+                throw new IllegalStateException(
+                        "weather.http.proxy is not a valid http(s)://[user:password@]host[:port] URL");
+            }
+            log.warn("Ignoring {}: not a valid http(s)://[user:password@]host[:port] URL", source);
+            return null;
+        }
+
+        var port = uri.getPort() != -1 ? uri.getPort() : uri.getScheme().equals("https") ? 443 : 80;
+        var proxy = new Proxy(Proxy.Type.HTTP, InetSocketAddress.createUnresolved(uri.getHost(), port));
+
+        String credentials = null;
+        if (uri.getUserInfo() != null) {
+            var userInfo = uri.getUserInfo().split(":", 2);
+            credentials = Credentials.basic(userInfo[0], userInfo.length > 1 ? userInfo[1] : "");
+        }
+
+        var nonProxyHosts = properties.nonProxyHosts() != null ? properties.nonProxyHosts()
+                : splitNoProxy(firstNonEmpty(env.get("NO_PROXY"), env.get("no_proxy")));
+        return new ProxySettings(proxy, credentials, nonProxyHosts);
+    }
+
+    private static URI parseProxyUrl(String value) {
+        try {
+            var uri = new URI(value.contains("://") ? value : "http://" + value);
+            if (uri.getHost() == null || !(uri.getScheme().equals("http") || uri.getScheme().equals("https"))) {
+                return null;
+            }
+            return uri;
+        } catch (URISyntaxException e) {
+            return null;
+        }
+    }
+
+    private static Authenticator proxyAuthenticator(String credentials) {
+        return (route, response) -> {
+            // Give up if the proxy rejected these credentials already.
+            if (response.request().header("Proxy-Authorization") != null) {
+                return null;
+            }
+            return response.request().newBuilder().header("Proxy-Authorization", credentials).build();
+        };
+    }
+
+    private static String firstNonEmpty(String... values) {
+        for (var value : values) {
+            if (StringUtils.hasText(value)) {
+                return value;
+            }
+        }
+        return null;
+    }
+
+    private static List<String> splitNoProxy(String noProxy) {
+        if (noProxy == null) {
+            return List.of();
+        }
+        return Arrays.stream(noProxy.split(",")).map(String::trim).filter(StringUtils::hasText).toList();
+    }
+
+    static final class NonProxyHostsSelector extends ProxySelector {
+        private final List<Proxy> proxy;
+        private final List<String> nonProxyHosts;
+
+        NonProxyHostsSelector(Proxy proxy, List<String> nonProxyHosts) {
+            this.proxy = List.of(proxy);
+            this.nonProxyHosts = nonProxyHosts;
+        }
+
+        @Override
+        public List<Proxy> select(URI uri) {
+            return bypass(uri.getHost()) ? List.of(Proxy.NO_PROXY) : proxy;
+        }
+
+        @Override
+        public void connectFailed(URI uri, SocketAddress address, IOException e) {
+        }
+
+        private boolean bypass(String host) {
+            for (var entry : nonProxyHosts) {
+                var domain = entry.startsWith(".") ? entry.substring(1) : entry;
+                if (entry.equals("*") || host.equalsIgnoreCase(domain)
+                        || host.toLowerCase().endsWith("." + domain.toLowerCase())) {
+                    return true;
+                }
+            }
+            return false;
+        }
+    }
+}
@@ -39,9 +39,9 @@ public class WeatherService {
 	private final OkHttpClient client;
 	private final Moshi moshi;
 
-	public WeatherService() {
+	public WeatherService(OkHttpClient client) {
 
-		this.client = new OkHttpClient();
+		this.client = client;
 		this.moshi = new Moshi.Builder().build();
 	}
 
@@ -183,7 +183,7 @@ public class WeatherService {
 	}
 
 	public static void main(String[] args) {
-		WeatherService client = new WeatherService();
+		WeatherService client = new WeatherService(new OkHttpClient());
 		System.out.println(client.getWeatherForecastByLocation(47.6062, -122.3321));
 		System.out.println(client.getAlerts("NY"));
 	}